@@ -0,0 +1,64 @@
+// Package passes holds small ast.Transform-based rewrites over a parsed
+// Program. It's meant as a worked example of the ast.Visitor/Transform API
+// more than a production optimizer.
+package passes
+
+import (
+	"strconv"
+
+	"monkey/ast"
+	"monkey/token"
+)
+
+// FoldConstants collapses integer-literal arithmetic (e.g. `1 + 2`) into a
+// single IntegerLiteral wherever it appears in program, working bottom-up
+// so nested expressions like `1 + 2 + 3` fold all the way down.
+func FoldConstants(program *ast.Program) *ast.Program {
+	return ast.Transform(program, foldNode).(*ast.Program)
+}
+
+func foldNode(node ast.Node) ast.Node {
+	infix, ok := node.(*ast.InfixExpression)
+	if !ok {
+		return node
+	}
+
+	left, ok := infix.Left.(*ast.IntegerLiteral)
+	if !ok {
+		return node
+	}
+
+	right, ok := infix.Right.(*ast.IntegerLiteral)
+	if !ok {
+		return node
+	}
+
+	value, ok := foldIntegers(left.Value, infix.Operator, right.Value)
+	if !ok {
+		return node
+	}
+
+	literal := strconv.FormatInt(value, 10)
+	return &ast.IntegerLiteral{
+		Token: token.Token{Type: token.INT, Literal: literal, Pos: infix.Token.Pos},
+		Value: value,
+	}
+}
+
+func foldIntegers(left int64, operator string, right int64) (int64, bool) {
+	switch operator {
+	case "+":
+		return left + right, true
+	case "-":
+		return left - right, true
+	case "*":
+		return left * right, true
+	case "/":
+		if right == 0 {
+			return 0, false
+		}
+		return left / right, true
+	default:
+		return 0, false
+	}
+}
@@ -0,0 +1,42 @@
+package passes
+
+import (
+	"testing"
+
+	"monkey/ast"
+	"monkey/lexer"
+	"monkey/parser"
+)
+
+func parseProgram(t *testing.T, input string) *ast.Program {
+	t.Helper()
+
+	l := lexer.New(input)
+	p := parser.New(l)
+	program := p.ParseProgram()
+	if len(p.Errors()) != 0 {
+		t.Fatalf("parser errors: %v", p.Errors())
+	}
+	return program
+}
+
+func TestFoldConstants(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"1 + 2;", "3"},
+		{"1 + 2 + 3;", "6"},
+		{"10 - 4;", "6"},
+		{"x + 1;", "(x + 1)"}, // not foldable, x isn't a literal
+	}
+
+	for _, tt := range tests {
+		program := parseProgram(t, tt.input)
+		folded := FoldConstants(program)
+
+		if got := folded.String(); got != tt.expected {
+			t.Errorf("FoldConstants(%q) = %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
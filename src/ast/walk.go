@@ -0,0 +1,117 @@
+package ast
+
+// Visitor is implemented by callers that want to walk the AST without
+// hand-rolling the recursion for each node kind. Visit is called for every
+// node Walk descends into; returning nil stops Walk from visiting that
+// node's children (mirroring the go/ast Visitor convention), otherwise the
+// returned Visitor is used for the children.
+type Visitor interface {
+	Visit(node Node) Visitor
+}
+
+// Walk traverses node in depth-first order, calling v.Visit for node and
+// every node reachable from it.
+func Walk(v Visitor, node Node) {
+	if node == nil {
+		return
+	}
+
+	v = v.Visit(node)
+	if v == nil {
+		return
+	}
+
+	switch n := node.(type) {
+	case *Program:
+		for _, s := range n.Statements {
+			Walk(v, s)
+		}
+
+	case *LetStatement:
+		Walk(v, n.Name)
+		if n.Value != nil {
+			Walk(v, n.Value)
+		}
+
+	case *ReturnStatement:
+		if n.ReturnValue != nil {
+			Walk(v, n.ReturnValue)
+		}
+
+	case *ExpressionStatement:
+		if n.Expression != nil {
+			Walk(v, n.Expression)
+		}
+
+	case *PrefixExpression:
+		Walk(v, n.Right)
+
+	case *InfixExpression:
+		Walk(v, n.Left)
+		Walk(v, n.Right)
+
+	case *Identifier, *IntegerLiteral:
+		// leaf nodes, nothing further to walk
+
+	default:
+		panic("ast.Walk: unexpected node type")
+	}
+}
+
+// Transform rebuilds node bottom-up, replacing every descendant (and then
+// node itself) with fn's result. Children are transformed before their
+// parent, so fn always sees already-transformed children - the shape a
+// pass like constant folding needs. fn must return a value assignable back
+// to the position it replaces (an Expression in for an Expression, etc).
+func Transform(node Node, fn func(Node) Node) Node {
+	switch n := node.(type) {
+	case *Program:
+		stmts := make([]Statement, len(n.Statements))
+		for i, s := range n.Statements {
+			stmts[i] = Transform(s, fn).(Statement)
+		}
+		return fn(&Program{Statements: stmts})
+
+	case *LetStatement:
+		let := &LetStatement{Token: n.Token, Name: Transform(n.Name, fn).(*Identifier)}
+		if n.Value != nil {
+			let.Value = Transform(n.Value, fn).(Expression)
+		}
+		return fn(let)
+
+	case *ReturnStatement:
+		ret := &ReturnStatement{Token: n.Token}
+		if n.ReturnValue != nil {
+			ret.ReturnValue = Transform(n.ReturnValue, fn).(Expression)
+		}
+		return fn(ret)
+
+	case *ExpressionStatement:
+		stmt := &ExpressionStatement{Token: n.Token}
+		if n.Expression != nil {
+			stmt.Expression = Transform(n.Expression, fn).(Expression)
+		}
+		return fn(stmt)
+
+	case *PrefixExpression:
+		return fn(&PrefixExpression{
+			Token:    n.Token,
+			Operator: n.Operator,
+			Right:    Transform(n.Right, fn).(Expression),
+		})
+
+	case *InfixExpression:
+		return fn(&InfixExpression{
+			Token:    n.Token,
+			Operator: n.Operator,
+			Left:     Transform(n.Left, fn).(Expression),
+			Right:    Transform(n.Right, fn).(Expression),
+		})
+
+	case *Identifier, *IntegerLiteral:
+		return fn(node)
+
+	default:
+		return fn(node)
+	}
+}
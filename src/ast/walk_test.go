@@ -0,0 +1,49 @@
+package ast
+
+import (
+	"testing"
+
+	"monkey/token"
+)
+
+type countingVisitor struct {
+	idents []string
+}
+
+func (c *countingVisitor) Visit(node Node) Visitor {
+	if ident, ok := node.(*Identifier); ok {
+		c.idents = append(c.idents, ident.Value)
+	}
+	return c
+}
+
+func TestWalkVisitsEveryIdentifier(t *testing.T) {
+	// let x = a + b;
+	program := &Program{
+		Statements: []Statement{
+			&LetStatement{
+				Token: token.Token{Type: token.LET, Literal: "let"},
+				Name:  &Identifier{Token: token.Token{Type: token.IDENT, Literal: "x"}, Value: "x"},
+				Value: &InfixExpression{
+					Token:    token.Token{Type: token.PLUS, Literal: "+"},
+					Operator: "+",
+					Left:     &Identifier{Token: token.Token{Type: token.IDENT, Literal: "a"}, Value: "a"},
+					Right:    &Identifier{Token: token.Token{Type: token.IDENT, Literal: "b"}, Value: "b"},
+				},
+			},
+		},
+	}
+
+	v := &countingVisitor{}
+	Walk(v, program)
+
+	expected := []string{"x", "a", "b"}
+	if len(v.idents) != len(expected) {
+		t.Fatalf("expected %d identifiers, got %d: %v", len(expected), len(v.idents), v.idents)
+	}
+	for i, name := range expected {
+		if v.idents[i] != name {
+			t.Errorf("idents[%d] = %q, want %q", i, v.idents[i], name)
+		}
+	}
+}
@@ -1,43 +1,70 @@
 package lexer
 
-import "monkey/token"
+import (
+	"unicode"
+	"unicode/utf8"
+
+	"monkey/token"
+)
 
 type Lexer struct {
-	input string // only ascii characters are
-	position int // current position in input (points to curret char)
-	readPosition int // current reading position in input (after current char)
-	ch byte // current char under examination, must be an ascii character
+	input string // unicode text, read rune by rune
+	position int // byte position of l.ch in input
+	readPosition int // byte position of the next rune to read
+	ch rune // current rune under examination, 0 on EOF
+
+	filename string // name reported in token positions, empty for the REPL
+	line     int // 1-indexed line of l.ch
+	column   int // 1-indexed column of l.ch, counted in runes, not bytes
 }
 
 func New(input string) *Lexer {
-	l := &Lexer{input: input}
+	return NewFile(input, "")
+}
+
+// NewFile is like New but stamps every token with filename, so callers that
+// load Monkey source from disk (or any future file-loader) get positions
+// usable in diagnostics.
+func NewFile(input string, filename string) *Lexer {
+	l := &Lexer{input: input, filename: filename, line: 1}
 	l.readChar() // readChar immediately so we populate readPosition and position correctly.
 	return l
-} 
+}
 
-// read the next ascii char in the Lexer's input string. 
+// read the next rune in the Lexer's input string.
 // this is not idempotent. Reading the next progresses the Lexer's positions through the input string.
 func (l *Lexer) readChar() {
-	// we only support ascii characters (for now). Supporting unicode characters would mean chars could no longer be represented as bytes
-	// but instead as runes, complicating this "next logic" and being unable to traverse the string simply, since a rune could be multiple bytes.
+	if l.ch == '\n' {
+		l.line++
+		l.column = 0
+	}
 
 	if l.readPosition >= len(l.input) {
-		l.ch= 0 // 0 is ascii NUL character
+		l.ch = 0 // 0 signals EOF
+		l.position = l.readPosition
 	} else {
-		l.ch = l.input[l.readPosition]
+		ch, width := utf8.DecodeRuneInString(l.input[l.readPosition:])
+		l.ch = ch
+		l.position = l.readPosition
+		l.readPosition += width
 	}
-	l.position = l.readPosition
-	l.readPosition += 1
+	l.column++
 }
 
-// Peak the next char in the Lexer's input string, without moving the lexer's positions. 
+// Pos returns the position of l.ch, the character the Lexer is currently
+// sitting on.
+func (l *Lexer) Pos() token.Pos {
+	return token.Pos{Filename: l.filename, Line: l.line, Column: l.column}
+}
+
+// Peak the next rune in the Lexer's input string, without moving the lexer's positions.
 // this is idempotent. peakChar() can be called repeatedly without changing the state of the Lexer.
-func (l *Lexer) peakChar() byte {
-	if l.position >= len(l.input) {
+func (l *Lexer) peakChar() rune {
+	if l.readPosition >= len(l.input) {
 		return 0
-	} else {
-		return l.input[l.readPosition]
 	}
+	ch, _ := utf8.DecodeRuneInString(l.input[l.readPosition:])
+	return ch
 }
 
 func (l *Lexer) NextToken() token.Token {
@@ -45,8 +72,10 @@ func (l *Lexer) NextToken() token.Token {
 
 	l.skipWhitespace()
 
+	pos := l.Pos()
+
 	switch l.ch {
-	case '=': 
+	case '=':
 		if l.peakChar() == '=' {
 			ch := l.ch
 			l.readChar()
@@ -55,11 +84,11 @@ func (l *Lexer) NextToken() token.Token {
 		} else {
 			tok = newToken(token.ASSIGN, l.ch)
 		}
-	case '+': 
+	case '+':
 			tok = newToken(token.PLUS, l.ch)
-	case '-': 
+	case '-':
 			tok = newToken(token.MINUS, l.ch)
-	case '!': 
+	case '!':
 		if l.peakChar() == '=' {
 			ch := l.ch
 			l.readChar()
@@ -68,44 +97,47 @@ func (l *Lexer) NextToken() token.Token {
 		} else {
 			tok = newToken(token.BANG, l.ch)
 		}
-	case '/': 
+	case '/':
 			tok = newToken(token.SLASH, l.ch)
-	case '*': 
+	case '*':
 			tok = newToken(token.ASTERISK, l.ch)
-	case '<': 
+	case '<':
 			tok = newToken(token.LT, l.ch)
-	case '>': 
+	case '>':
 			tok = newToken(token.GT, l.ch)
-	case ';': 
+	case ';':
 		tok = newToken(token.SEMICOLON, l.ch)
-	case '(': 
+	case '(':
 		tok = newToken(token.LPAREN, l.ch)
-	case ')': 
+	case ')':
 		tok = newToken(token.RPAREN, l.ch)
-	case ',': 
+	case ',':
 		tok = newToken(token.COMMA, l.ch)
-	case '{': 
+	case '{':
 		tok = newToken(token.LBRACE, l.ch)
-	case '}': 
+	case '}':
 		tok = newToken(token.RBRACE, l.ch)
-	case 0: 
+	case 0:
 		tok.Literal = ""
 		tok.Type = token.EOF
 	default:
 		if isLetter(l.ch) {
 			tok.Literal = l.readIdentifier()
 			tok.Type = token.LookupIdent(tok.Literal)
+			tok.Pos = pos
 			// return early, we've already progressed our lexer during readIdentifier
 			return tok
 			} else if isDigit(l.ch) {
 				tok.Literal = l.readNumber()
 				tok.Type = token.INT
+				tok.Pos = pos
 				// return early, we've already progressed our lexer during readNumber
 				return tok
 		} else {
 			tok = newToken(token.ILLEGAL, l.ch)
 		}
 	}
+	tok.Pos = pos
 	l.readChar()
 	return tok
 }
@@ -117,14 +149,16 @@ func (l *Lexer) skipWhitespace() {
 	}
 }
 
-// determines the valid characters that can be used in keywords/identifiers. 
-// These EXPLICITLY should not be bytes that are mapped to already existing tokens.
-func isLetter(ch byte) bool {
-	return 'a' <= ch && ch <= 'z' || 'A' <= ch && ch <= 'Z' || ch == '_' // add ch == '?' and ch == '!'?
+// determines the valid characters that can be used in keywords/identifiers.
+// Any Unicode letter is accepted (so identifiers like π, λ, or 変数 tokenize
+// as IDENT), plus '_'. These EXPLICITLY should not be runes that are mapped
+// to already existing tokens.
+func isLetter(ch rune) bool {
+	return unicode.IsLetter(ch) || ch == '_' // add ch == '?' and ch == '!'?
 }
 
 func (l *Lexer) readIdentifier() string {
-	// we cache the starting position
+	// we cache the starting byte position
 	position := l.position
 	// progress the lexer until we read a character that isn't a letter
 	for isLetter(l.ch) {
@@ -134,12 +168,13 @@ func (l *Lexer) readIdentifier() string {
 	return l.input[position:l.position]
 }
 
-func isDigit(ch byte) bool {
-	return '0' <= ch && ch <= '9'
+// isDigit accepts any Unicode decimal digit, not just ASCII 0-9.
+func isDigit(ch rune) bool {
+	return unicode.IsDigit(ch)
 }
 
 func (l *Lexer) readNumber() string {
-	// we cache the starting position
+	// we cache the starting byte position
 	position := l.position
 	// progress the lexer until we read a character that isn't a letter
 	for isDigit(l.ch) {
@@ -149,6 +184,6 @@ func (l *Lexer) readNumber() string {
 	return l.input[position:l.position]
 }
 
-func newToken(tokenType token.TokenType, ch byte) token.Token {
+func newToken(tokenType token.TokenType, ch rune) token.Token {
 	return token.Token{Type: tokenType, Literal: string(ch)}
 }
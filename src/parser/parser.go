@@ -1,291 +1,47 @@
+// Package parser exposes Monkey's pluggable parsing front end. Two
+// backends currently implement it: parser/pratt, the original hand-written
+// precedence-climbing parser, and parser/peg, a grammar-driven alternative
+// for the same language.
 package parser
 
 import (
-	"fmt"
 	"monkey/ast"
 	"monkey/lexer"
-	"monkey/token"
-	"strconv"
+	"monkey/parser/peg"
+	"monkey/parser/pratt"
 )
 
-// these constants and the precedences map are the backbone of operator precedence
-const (
-	_ int = iota // iota creates an auto incrementing set of values in this block, much like an ENUM in other languages. LOWEST will have the int value 1, EQUALS 2, etc.
-	LOWEST
-	EQUALS      // ==
-	LESSGREATER // > or <
-	SUM         // +
-	PRODUCT     // *
-	PREFIX      // -X or !X
-	CALL        // myFunction(X)
-)
-
-var precedences = map[token.TokenType]int{
-	token.EQ:       EQUALS,
-	token.NOT_EQ:   EQUALS,
-	token.LT:       LESSGREATER,
-	token.GT:       LESSGREATER,
-	token.PLUS:     SUM,
-	token.MINUS:    SUM,
-	token.SLASH:    PRODUCT,
-	token.ASTERISK: PRODUCT,
-}
-
-type Parser struct {
-	l *lexer.Lexer
-
-	curToken  token.Token
-	peekToken token.Token
-	errors    []string
-
-	// these maps allow more programmatic registraction and evaluation of parsing fns, rather than more switch cases
-	prefixParseFns map[token.TokenType]prefixParseFn
-	infixParseFns  map[token.TokenType]infixParseFn
-}
-
-func New(l *lexer.Lexer) *Parser {
-	p := &Parser{
-		l:      l,
-		errors: []string{},
-	}
-
-	// Read two tokens, so curToken and peekToken are both set
-	p.nextToken()
-	p.nextToken()
-	// register our prefix parsers... TODO explain why we register IDENT and INT as prefix?
-	p.prefixParseFns = make(map[token.TokenType]prefixParseFn)
-	p.registerPrefix(token.IDENT, p.parseIdentifier)
-	p.registerPrefix(token.INT, p.parseIntegerLiteral)
-	p.registerPrefix(token.BANG, p.parsePrefixExpression)
-	p.registerPrefix(token.MINUS, p.parsePrefixExpression)
-	// register infix expressions
-	p.infixParseFns = make(map[token.TokenType]infixParseFn)
-	p.registerInfix(token.PLUS, p.parseInfixExpression)
-	p.registerInfix(token.MINUS, p.parseInfixExpression)
-	p.registerInfix(token.SLASH, p.parseInfixExpression)
-	p.registerInfix(token.ASTERISK, p.parseInfixExpression)
-	p.registerInfix(token.NOT_EQ, p.parseInfixExpression)
-	p.registerInfix(token.EQ, p.parseInfixExpression)
-	p.registerInfix(token.LT, p.parseInfixExpression)
-	p.registerInfix(token.GT, p.parseInfixExpression)
-
-	return p
-}
-
-func (p *Parser) parseIdentifier() ast.Expression {
-	return &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
-}
-
-func (p *Parser) nextToken() {
-	p.curToken = p.peekToken
-	p.peekToken = p.l.NextToken()
-}
-
-func (p *Parser) ParseProgram() *ast.Program {
-	program := &ast.Program{}
-	program.Statements = []ast.Statement{}
-
-	for !p.curTokenIs(token.EOF) {
-		stmt := p.parseStatement()
-		if stmt != nil {
-			program.Statements = append(program.Statements, stmt)
-		}
-		p.nextToken()
-	}
-	return program
-}
-
-func (p *Parser) parseStatement() ast.Statement {
-	switch p.curToken.Type {
-	case token.LET:
-		return p.parseLetStatement()
-	case token.RETURN:
-		return p.parseReturnStatement()
-	default:
-		return p.parseExpressionStatement()
-	}
-}
-
-// When we are parsing a let statment, we know the shape we want, ie let x = 5;
-func (p *Parser) parseLetStatement() *ast.LetStatement {
-	stmt := &ast.LetStatement{Token: p.curToken}
-
-	// expect the next token to be an identifiver, such as 'x'. expectPeek checks and increments parser
-	if !p.expectPeek(token.IDENT) {
-		return nil
-	}
-
-	// expectPeek has progressed the token to 'x', so we make that our stmt
-	stmt.Name = &ast.Identifier{Token: p.curToken, Value: p.curToken.Literal}
-
-	// if our next token isn't an equals, we're invalid, ie let x + y = 5
-	if !p.expectPeek(token.ASSIGN) {
-		return nil
-	}
-
-	//TODO: we're skipping the expressions until we encounter a semicolon
-	for !p.curTokenIs(token.SEMICOLON) {
-		p.nextToken()
-	}
-	return stmt
-}
-
-func (p *Parser) parseReturnStatement() *ast.ReturnStatement {
-	stmt := &ast.ReturnStatement{Token: p.curToken}
-	p.nextToken()
-
-	// TODO we're skipping the expressions until we encounter a semicolon
-
-	for !p.curTokenIs(token.SEMICOLON) {
-		p.nextToken()
-	}
-	return stmt
-}
-
-func (p *Parser) parseExpressionStatement() *ast.ExpressionStatement {
-	stmt := &ast.ExpressionStatement{Token: p.curToken}
-
-	stmt.Expression = p.parseExpression(LOWEST)
-
-	// semicolons are optional in statements
-	if p.peekTokenIs(token.SEMICOLON) {
-		p.nextToken()
-	}
-
-	return stmt
-}
-
-func (p *Parser) parseExpression(precedence int) ast.Expression {
-	// TODO consider precedence here.
-	prefixParseFn := p.prefixParseFns[p.curToken.Type]
-	if prefixParseFn == nil {
-		p.noPrefixParseFnError(p.curToken.Type)
-		return nil
-	}
-	leftExp := prefixParseFn()
-
-	// This loop is most of the magic here.
-	// if we are parsing an expression, like -a + b + c,
-	// we parse -a first (above) we then peek and see that +, or SUM is higher than LOWEST, which is the
-	// "default" precedence when beginning parsing an expression statement.
-	// so we go about parsing the "first" infix expression, -a + b.
-	// During that parsing, the tokens are progressed and this parseExpression fn is called again with the provided SUM precedence.
-	// We handle the "left" side of the expression above, and then peek at the token after b, which is another SUM precedence,
-	// so we don't call infixParseFn and we just return -a + b;
-	// When that completes, we are back into the "top level" parseExpression call, where precedence is still LOWEST.
-	// This causes us to parse the second part of the infix, because the "+" between b and c is higher than LOWEST.
-	// But our existing leftExp has been updated from (-a) to (-a + b) and the tokens have been progressed from the first
-	// iteration of this loop at this level.
-	// after parsing b + c, we end up with the nesting (((-a) + b) + c)
-	for !p.peekTokenIs(token.SEMICOLON) && precedence < p.peekPrecedence() {
-		infixParseFn := p.infixParseFns[p.peekToken.Type]
-		if infixParseFn == nil {
-			return leftExp
-		}
-
-		p.nextToken()
-
-		leftExp = infixParseFn(leftExp)
-	}
-	return leftExp
-}
-
-func (p *Parser) curTokenIs(t token.TokenType) bool {
-	return p.curToken.Type == t
-}
-
-func (p *Parser) peekTokenIs(t token.TokenType) bool {
-	return p.peekToken.Type == t
-}
-
-// inspect AND progress the token if it matches
-func (p *Parser) expectPeek(t token.TokenType) bool {
-	if p.peekTokenIs(t) {
-		p.nextToken()
-		return true
-	} else {
-		p.peekError(t)
-		return false
-	}
-}
-
-func (p *Parser) Errors() []string {
-	return p.errors
-}
-
-func (p *Parser) peekError(t token.TokenType) []string {
-	msg := fmt.Sprintf("expected next token to be %s, got %s instead", t, p.peekToken.Type)
-	p.errors = append(p.errors, msg)
-	return p.errors
-}
-
-func (p *Parser) peekPrecedence() int {
-	if p, ok := precedences[p.peekToken.Type]; ok {
-		return p
-	}
-	// sensible default
-	return LOWEST
+// Parser is implemented by every Monkey parsing backend. ParseProgram
+// consumes l's entire input; Errors reports whatever went wrong along the
+// way (a backend may keep going past an error to collect more of them).
+type Parser interface {
+	ParseProgram() *ast.Program
+	Errors() []string
 }
 
-func (p *Parser) curPrecedence() int {
-	if p, ok := precedences[p.curToken.Type]; ok {
-		return p
-	}
-	// sensible default
-	return LOWEST
-}
+// Mode selects which backend New builds.
+type Mode int
 
-type (
-	prefixParseFn func() ast.Expression
-	infixParseFn  func(ast.Expression) ast.Expression // infixParse takes "left side" expression
+const (
+	// ModePratt is the default: the fast, hand-written Pratt parser that
+	// has backed this package since the start.
+	ModePratt Mode = iota
+	// ModePEG parses the same grammar via parser/peg instead.
+	ModePEG
 )
 
-func (p *Parser) registerPrefix(tokenType token.TokenType, fn prefixParseFn) {
-	p.prefixParseFns[tokenType] = fn
-}
-
-func (p *Parser) registerInfix(tokenType token.TokenType, fn infixParseFn) {
-	p.infixParseFns[tokenType] = fn
-}
-
-func (p *Parser) parseIntegerLiteral() ast.Expression {
-	lit := &ast.IntegerLiteral{Token: p.curToken}
-
-	value, err := strconv.ParseInt(p.curToken.Literal, 0, 64)
-	if err != nil {
-		msg := fmt.Sprintf("could not parse %q as integer", p.curToken.Literal)
-		p.errors = append(p.errors, msg)
-		return nil
+// New builds a Parser for l using mode. mode defaults to ModePratt, so
+// existing callers of New(l) keep working unchanged.
+func New(l *lexer.Lexer, mode ...Mode) Parser {
+	m := ModePratt
+	if len(mode) > 0 {
+		m = mode[0]
 	}
-	lit.Value = value
-	return lit
-}
 
-func (p *Parser) noPrefixParseFnError(t token.TokenType) {
-	msg := fmt.Sprintf("no prefix parse function for %s found", t)
-	p.errors = append(p.errors, msg)
-}
-
-func (p *Parser) parsePrefixExpression() ast.Expression {
-	expression := &ast.PrefixExpression{Token: p.curToken, Operator: p.curToken.Literal}
-
-	p.nextToken()
-
-	expression.Right = p.parseExpression(PREFIX)
-
-	return expression
-}
-
-func (p *Parser) parseInfixExpression(left ast.Expression) ast.Expression {
-	expression := &ast.InfixExpression{
-		Token:    p.curToken,
-		Operator: p.curToken.Literal,
-		Left:     left,
+	switch m {
+	case ModePEG:
+		return peg.New(l)
+	default:
+		return pratt.New(l)
 	}
-
-	precedence := p.curPrecedence()
-	p.nextToken()
-	expression.Right = p.parseExpression(precedence) // this can kick off a recursive call stack, since parseExpression calls parseInfixExpression!
-
-	return expression
 }
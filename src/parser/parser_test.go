@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"fmt"
+	"testing"
+
+	"monkey/lexer"
+)
+
+// backends runs every test below against both parsing backends, so a
+// grammar change that only lands in one of them gets caught immediately.
+var backends = []struct {
+	name string
+	mode Mode
+}{
+	{"pratt", ModePratt},
+	{"peg", ModePEG},
+}
+
+func TestParseProgram(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"let x = 5;", "let x = 5;"},
+		{"return 10;", "return 10;"},
+		{"-a + b;", "((-a) + b)"},
+		{"1 + 2 * 3;", "(1 + (2 * 3))"},
+		{"!5;", "(!5)"},
+	}
+
+	for _, backend := range backends {
+		for _, tt := range tests {
+			t.Run(fmt.Sprintf("%s/%s", backend.name, tt.input), func(t *testing.T) {
+				p := New(lexer.New(tt.input), backend.mode)
+				program := p.ParseProgram()
+
+				if len(p.Errors()) != 0 {
+					t.Fatalf("parser errors: %v", p.Errors())
+				}
+
+				if got := program.String(); got != tt.expected {
+					t.Errorf("got %q, want %q", got, tt.expected)
+				}
+			})
+		}
+	}
+}
+
+func TestParseProgramErrors(t *testing.T) {
+	for _, backend := range backends {
+		t.Run(backend.name, func(t *testing.T) {
+			p := New(lexer.New("let = 5;"), backend.mode)
+			p.ParseProgram()
+
+			if len(p.Errors()) == 0 {
+				t.Fatalf("expected a parse error, got none")
+			}
+		})
+	}
+}
+
+const benchInput = `
+let a = 1;
+let b = 2;
+let result = a + b * 3 - a + b;
+return result + 1 - 2 * 3;
+`
+
+func BenchmarkPratt(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		New(lexer.New(benchInput), ModePratt).ParseProgram()
+	}
+}
+
+func BenchmarkPEG(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		New(lexer.New(benchInput), ModePEG).ParseProgram()
+	}
+}
@@ -0,0 +1,152 @@
+package peg
+
+import "monkey/token"
+
+// This file is the actual PEG engine: a handful of generic combinators
+// that interpret grammar rules built out of other rules (seq, choice,
+// star, ...) rather than each nonterminal getting its own hand-written
+// Go function. grammar.go is the only place that knows about Monkey's
+// syntax - everything here is grammar-agnostic and would work for any
+// token-based PEG grammar.
+
+// input is the engine's view of the token stream. Ordered choice needs
+// arbitrary backtracking, so (unlike the single cur/peek-token lookahead
+// parser/pratt uses) the whole input is tokenized up front and a
+// rule just gets handed the position to resume from on failure, rather
+// than mutating shared cursor state.
+type input struct {
+	tokens []token.Token
+
+	// farthest tracks the rightmost position any rule managed to reach,
+	// and what token type it was hoping to find there - a packrat
+	// parser's conventional heuristic for "where did parsing actually
+	// go wrong", since ordered choice backtracks past the real failure
+	// silently otherwise.
+	farthest         int
+	farthestExpected token.TokenType
+}
+
+func (s *input) at(pos int) token.Token {
+	if pos >= len(s.tokens) {
+		return s.tokens[len(s.tokens)-1] // EOF
+	}
+	return s.tokens[pos]
+}
+
+func (s *input) noteFailure(pos int, expected token.TokenType) {
+	if pos > s.farthest {
+		s.farthest = pos
+		s.farthestExpected = expected
+	}
+}
+
+// rule is one PEG expression: given a cursor position, it either matches
+// (returning the position just past the match and a value) or fails.
+type rule func(s *input, pos int) (next int, value interface{}, ok bool)
+
+// tok <- TOKENTYPE. The only rule that actually consumes input; every
+// other combinator just combines rules built out of this one.
+func tok(tt token.TokenType) rule {
+	return func(s *input, pos int) (int, interface{}, bool) {
+		t := s.at(pos)
+		if t.Type != tt {
+			s.noteFailure(pos, tt)
+			return pos, nil, false
+		}
+		return pos + 1, t, true
+	}
+}
+
+// currentToken always succeeds without consuming input, returning the
+// token sitting at pos. It's how a seq can capture "the token this
+// statement started at" for an ast.Node's Token field alongside parsing
+// the rest of the production.
+func currentToken() rule {
+	return func(s *input, pos int) (int, interface{}, bool) {
+		return pos, s.at(pos), true
+	}
+}
+
+// seq <- e1 e2 ... en. On success, value is []interface{} of each
+// sub-expression's value, in order.
+func seq(exprs ...rule) rule {
+	return func(s *input, pos int) (int, interface{}, bool) {
+		vals := make([]interface{}, 0, len(exprs))
+		cur := pos
+		for _, e := range exprs {
+			next, v, ok := e(s, cur)
+			if !ok {
+				return pos, nil, false
+			}
+			vals = append(vals, v)
+			cur = next
+		}
+		return cur, vals, true
+	}
+}
+
+// choice <- e1 / e2 / ... / en. Tries each alternative in order and
+// commits to the first that matches - PEG's defining feature, as opposed
+// to CFG disjunction, which would explore all of them.
+func choice(exprs ...rule) rule {
+	return func(s *input, pos int) (int, interface{}, bool) {
+		for _, e := range exprs {
+			if next, v, ok := e(s, pos); ok {
+				return next, v, true
+			}
+		}
+		return pos, nil, false
+	}
+}
+
+// star <- e*. Always succeeds; value is []interface{} of zero or more
+// matches.
+func star(e rule) rule {
+	return func(s *input, pos int) (int, interface{}, bool) {
+		var vals []interface{}
+		cur := pos
+		for {
+			next, v, ok := e(s, cur)
+			if !ok {
+				break
+			}
+			vals = append(vals, v)
+			cur = next
+		}
+		return cur, vals, true
+	}
+}
+
+// opt <- e?. Always succeeds; value is nil if e didn't match.
+func opt(e rule) rule {
+	return func(s *input, pos int) (int, interface{}, bool) {
+		if next, v, ok := e(s, pos); ok {
+			return next, v, true
+		}
+		return pos, nil, true
+	}
+}
+
+// action runs fn over e's matched value, letting a grammar rule build
+// its ast.Node alongside the grammar shape that produced it.
+func action(e rule, fn func(interface{}) interface{}) rule {
+	return func(s *input, pos int) (int, interface{}, bool) {
+		next, v, ok := e(s, pos)
+		if !ok {
+			return pos, nil, false
+		}
+		return next, fn(v), true
+	}
+}
+
+// ref looks up a named rule lazily, by reading g.rules at match time
+// rather than at grammar-construction time. That indirection is what
+// lets recursive productions (Prefix referring to itself) and rules that
+// name a rule defined later in newGrammar (LetStatement's Expression) be
+// wired up as plain map entries instead of needing Go-level forward
+// declarations.
+func ref(g *grammar, name string) rule {
+	return func(s *input, pos int) (int, interface{}, bool) {
+		return g.rules[name](s, pos)
+	}
+}
@@ -0,0 +1,154 @@
+package peg
+
+import (
+	"strconv"
+
+	"monkey/ast"
+	"monkey/token"
+)
+
+// grammar is the Monkey grammar from grammar/monkey.peg, built as data
+// (a name -> rule map) instead of as bespoke Go functions per
+// nonterminal. A real grammar change - say, adding hash literals - is a
+// matter of adding/editing an entry here and in the .peg file, not
+// rewriting a recursive-descent function by hand.
+//
+// Unlike parser/pratt, which walks a single Expression function
+// parameterized by a precedence int, operator precedence here is
+// declarative: each binary layer (Equality, Comparison, Sum, Product)
+// only recurses into the next tighter layer, so tighter-binding operators
+// are structurally unreachable from looser ones. That's the standard PEG
+// way to encode precedence without a runtime precedence table.
+type grammar struct {
+	rules map[string]rule
+	errs  *[]string
+}
+
+func newGrammar(errs *[]string) *grammar {
+	g := &grammar{rules: make(map[string]rule), errs: errs}
+
+	g.rules["Program"] = seq(star(ref(g, "Statement")), tok(token.EOF))
+
+	g.rules["Statement"] = choice(
+		ref(g, "LetStatement"),
+		ref(g, "ReturnStatement"),
+		ref(g, "ExpressionStatement"),
+	)
+
+	g.rules["LetStatement"] = action(
+		seq(tok(token.LET), tok(token.IDENT), tok(token.ASSIGN), ref(g, "Expression"), optSemicolon()),
+		func(v interface{}) interface{} {
+			parts := v.([]interface{})
+			letTok := parts[0].(token.Token)
+			nameTok := parts[1].(token.Token)
+			value := parts[3].(ast.Expression)
+			return ast.Statement(&ast.LetStatement{
+				Token: letTok,
+				Name:  &ast.Identifier{Token: nameTok, Value: nameTok.Literal},
+				Value: value,
+			})
+		},
+	)
+
+	g.rules["ReturnStatement"] = action(
+		seq(tok(token.RETURN), ref(g, "Expression"), optSemicolon()),
+		func(v interface{}) interface{} {
+			parts := v.([]interface{})
+			returnTok := parts[0].(token.Token)
+			value := parts[1].(ast.Expression)
+			return ast.Statement(&ast.ReturnStatement{Token: returnTok, ReturnValue: value})
+		},
+	)
+
+	g.rules["ExpressionStatement"] = action(
+		seq(currentToken(), ref(g, "Expression"), optSemicolon()),
+		func(v interface{}) interface{} {
+			parts := v.([]interface{})
+			leadTok := parts[0].(token.Token)
+			expr := parts[1].(ast.Expression)
+			return ast.Statement(&ast.ExpressionStatement{Token: leadTok, Expression: expr})
+		},
+	)
+
+	g.rules["Expression"] = ref(g, "Equality")
+
+	g.rules["Equality"] = binaryLayer(g, "Comparison", token.EQ, token.NOT_EQ)
+	g.rules["Comparison"] = binaryLayer(g, "Sum", token.LT, token.GT)
+	g.rules["Sum"] = binaryLayer(g, "Product", token.PLUS, token.MINUS)
+	g.rules["Product"] = binaryLayer(g, "Prefix", token.ASTERISK, token.SLASH)
+
+	g.rules["Prefix"] = choice(
+		action(
+			seq(choice(tok(token.BANG), tok(token.MINUS)), ref(g, "Prefix")),
+			func(v interface{}) interface{} {
+				parts := v.([]interface{})
+				opTok := parts[0].(token.Token)
+				right := parts[1].(ast.Expression)
+				return ast.Expression(&ast.PrefixExpression{Token: opTok, Operator: opTok.Literal, Right: right})
+			},
+		),
+		ref(g, "Primary"),
+	)
+
+	g.rules["Primary"] = choice(
+		action(tok(token.IDENT), func(v interface{}) interface{} {
+			t := v.(token.Token)
+			return ast.Expression(&ast.Identifier{Token: t, Value: t.Literal})
+		}),
+		g.integerLiteral(),
+	)
+
+	return g
+}
+
+// optSemicolon matches the optional trailing SEMICOLON every statement
+// production ends with; its value is discarded by callers.
+func optSemicolon() rule {
+	return opt(tok(token.SEMICOLON))
+}
+
+// binaryLayer builds one precedence level: operand (op operand)*, folding
+// the repetition into a left-associative InfixExpression chain. Equality,
+// Comparison, Sum and Product are all this same shape, just parameterized
+// by which tighter rule they recurse into and which operators they accept
+// - so the four layers share one implementation instead of four
+// hand-copied ones.
+func binaryLayer(g *grammar, operand string, ops ...token.TokenType) rule {
+	opChoices := make([]rule, len(ops))
+	for i, t := range ops {
+		opChoices[i] = tok(t)
+	}
+
+	return action(
+		seq(ref(g, operand), star(seq(choice(opChoices...), ref(g, operand)))),
+		func(v interface{}) interface{} {
+			parts := v.([]interface{})
+			left := parts[0].(ast.Expression)
+
+			reps, _ := parts[1].([]interface{})
+			for _, r := range reps {
+				pair := r.([]interface{})
+				opTok := pair[0].(token.Token)
+				right := pair[1].(ast.Expression)
+				left = &ast.InfixExpression{Token: opTok, Operator: opTok.Literal, Left: left, Right: right}
+			}
+			return left
+		},
+	)
+}
+
+// integerLiteral needs the grammar's error slice, unlike the other
+// productions, to report a malformed integer literal the same way
+// parser/pratt does - so it's a method instead of a free function
+// built once in newGrammar.
+func (g *grammar) integerLiteral() rule {
+	return action(tok(token.INT), func(v interface{}) interface{} {
+		t := v.(token.Token)
+		value, err := strconv.ParseInt(t.Literal, 0, 64)
+		if err != nil {
+			*g.errs = append(*g.errs, t.Pos.String()+": could not parse "+strconv.Quote(t.Literal)+" as integer")
+			value = 0
+		}
+		return ast.Expression(&ast.IntegerLiteral{Token: t, Value: value})
+	})
+}
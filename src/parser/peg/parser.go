@@ -0,0 +1,86 @@
+// Package peg is a genuine, if small, PEG parsing engine for Monkey: the
+// grammar (grammar.go) is data - a map of named rules built out of the
+// generic combinators in combinators.go (seq, choice, star, ref, ...) -
+// interpreted by that same generic machinery, rather than each production
+// getting its own hand-written recursive-descent Go function the way
+// parser/pratt does. Evolving the grammar (new operators, new literal
+// kinds) means editing grammar.go's rule table, not the engine.
+//
+// grammar/monkey.peg documents the same grammar for humans; keep the two
+// in sync when either changes.
+package peg
+
+import (
+	"fmt"
+
+	"monkey/ast"
+	"monkey/lexer"
+	"monkey/token"
+)
+
+type Parser struct {
+	in      *input
+	grammar *grammar
+	errors  []string
+}
+
+func New(l *lexer.Lexer) *Parser {
+	p := &Parser{errors: []string{}}
+	p.in = &input{tokens: tokenizeAll(l)}
+	p.grammar = newGrammar(&p.errors)
+	return p
+}
+
+func (p *Parser) Errors() []string {
+	return p.errors
+}
+
+// tokenizeAll drains l completely up front. A PEG engine needs arbitrary
+// backtracking for ordered choice, which an on-demand cur/peek-token
+// lexer interface can't give it cheaply - so unlike parser/pratt, this
+// backend tokenizes eagerly and backtracks by rewinding an
+// index instead.
+func tokenizeAll(l *lexer.Lexer) []token.Token {
+	var toks []token.Token
+	for {
+		t := l.NextToken()
+		toks = append(toks, t)
+		if t.Type == token.EOF {
+			return toks
+		}
+	}
+}
+
+// ParseProgram <- Program, i.e. Statement* EOF.
+func (p *Parser) ParseProgram() *ast.Program {
+	_, val, ok := p.grammar.rules["Program"](p.in, 0)
+	if !ok {
+		p.errors = append(p.errors, p.describeFailure())
+		return &ast.Program{Statements: []ast.Statement{}}
+	}
+
+	parts := val.([]interface{})
+	stmtVals, _ := parts[0].([]interface{})
+
+	stmts := make([]ast.Statement, len(stmtVals))
+	for i, v := range stmtVals {
+		stmts[i] = v.(ast.Statement)
+	}
+
+	return &ast.Program{Statements: stmts}
+}
+
+// describeFailure renders the engine's furthest-failure bookkeeping - the
+// rightmost position any rule reached before the whole grammar backed out
+// of it - into a message in the same file:line:col style parser/pratt
+// uses. It's necessarily a bit vaguer than parser/pratt's
+// "expected X, got Y": ordered choice backtracks through every failed
+// alternative, so by the time Program as a whole fails there's no single
+// "the" expectation left, just the deepest one the engine happened to hit.
+func (p *Parser) describeFailure() string {
+	tok := p.in.at(p.in.farthest)
+	if p.in.farthestExpected != "" {
+		return fmt.Sprintf("%s: expected %s, got %s instead", tok.Pos, p.in.farthestExpected, tok.Type)
+	}
+	return fmt.Sprintf("%s: no viable alternative, got %s instead", tok.Pos, tok.Type)
+}
@@ -0,0 +1,61 @@
+package peg
+
+import (
+	"testing"
+
+	"monkey/lexer"
+)
+
+func TestParseProgram(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"let x = 5;", "let x = 5;"},
+		{"return 10;", "return 10;"},
+		{"-a + b;", "((-a) + b)"},
+		{"1 + 2 * 3;", "(1 + (2 * 3))"},
+		{"!5;", "(!5)"},
+	}
+
+	for _, tt := range tests {
+		p := New(lexer.New(tt.input))
+		program := p.ParseProgram()
+
+		if len(p.Errors()) != 0 {
+			t.Fatalf("input %q: parser errors: %v", tt.input, p.Errors())
+		}
+
+		if got := program.String(); got != tt.expected {
+			t.Errorf("input %q: got %q, want %q", tt.input, got, tt.expected)
+		}
+	}
+}
+
+func TestErrorsIncludeSourcePosition(t *testing.T) {
+	p := New(lexer.NewFile("let = 5;", "file.mk"))
+	p.ParseProgram()
+
+	if len(p.Errors()) == 0 {
+		t.Fatalf("expected a parse error, got none")
+	}
+
+	want := "file.mk:1:5: expected IDENT, got = instead"
+	if p.Errors()[0] != want {
+		t.Errorf("error = %q, want %q", p.Errors()[0], want)
+	}
+}
+
+func TestIntegerParseErrorIncludesSourcePosition(t *testing.T) {
+	p := New(lexer.NewFile("99999999999999999999999;", "file.mk"))
+	p.ParseProgram()
+
+	if len(p.Errors()) == 0 {
+		t.Fatalf("expected a parse error, got none")
+	}
+
+	want := "file.mk:1:1: could not parse \"99999999999999999999999\" as integer"
+	if p.Errors()[0] != want {
+		t.Errorf("error = %q, want %q", p.Errors()[0], want)
+	}
+}
@@ -0,0 +1,54 @@
+package pratt
+
+import (
+	"strings"
+	"testing"
+
+	"monkey/lexer"
+)
+
+func TestErrorsIncludeSourcePosition(t *testing.T) {
+	tests := []struct {
+		input   string
+		wantHas string
+	}{
+		{"let x 5;", "file.mk:1:7: expected next token to be =, got INT instead"},
+		{"+5;", "file.mk:1:1: no prefix parse function for + found"},
+	}
+
+	for _, tt := range tests {
+		l := lexer.NewFile(tt.input, "file.mk")
+		p := New(l)
+		p.ParseProgram()
+
+		if len(p.Errors()) == 0 {
+			t.Fatalf("input %q: expected parser errors, got none", tt.input)
+		}
+
+		found := false
+		for _, msg := range p.Errors() {
+			if msg == tt.wantHas {
+				found = true
+				break
+			}
+		}
+		if !found {
+			t.Errorf("input %q: errors %v do not contain %q", tt.input, p.Errors(), tt.wantHas)
+		}
+	}
+}
+
+func TestIntegerParseErrorIncludesSourcePosition(t *testing.T) {
+	l := lexer.NewFile("99999999999999999999999;", "file.mk")
+	p := New(l)
+	p.ParseProgram()
+
+	if len(p.Errors()) == 0 {
+		t.Fatalf("expected a parse error, got none")
+	}
+
+	want := "file.mk:1:1: could not parse"
+	if !strings.HasPrefix(p.Errors()[0], want) {
+		t.Errorf("error = %q, want prefix %q", p.Errors()[0], want)
+	}
+}
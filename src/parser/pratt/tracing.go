@@ -0,0 +1,52 @@
+package pratt
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// TraceOn turns the trace/untrace helpers below into a no-op when false.
+// It defaults to on when MONKEY_PARSER_TRACE=1 is set in the environment,
+// which is handy for tracing a script without touching source.
+var TraceOn = os.Getenv("MONKEY_PARSER_TRACE") == "1"
+
+// TraceOutput is where trace/untrace write. It defaults to stdout but can
+// be swapped out (e.g. in tests, or to redirect tracing to a log file).
+var TraceOutput io.Writer = os.Stdout
+
+const traceIdentPlaceholder = "\t"
+
+var traceLevel int = 0
+
+func incIdent() { traceLevel = traceLevel + 1 }
+func decIdent() { traceLevel = traceLevel - 1 }
+
+func identLevel() string {
+	return strings.Repeat(traceIdentPlaceholder, traceLevel-1)
+}
+
+func tracePrint(fs string) {
+	fmt.Fprintf(TraceOutput, "%s%s\n", identLevel(), fs)
+}
+
+// trace prints "BEGIN msg", bumps the indent, and returns untrace's
+// partner so callers can write the usual `defer untrace(trace("..."))`.
+// It's a no-op unless TraceOn is set.
+func trace(msg string) string {
+	if !TraceOn {
+		return msg
+	}
+	incIdent()
+	tracePrint("BEGIN " + msg)
+	return msg
+}
+
+func untrace(msg string) {
+	if !TraceOn {
+		return
+	}
+	tracePrint("END " + msg)
+	decIdent()
+}
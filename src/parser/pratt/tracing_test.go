@@ -0,0 +1,81 @@
+package pratt
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestTraceUntrace(t *testing.T) {
+	tests := []struct {
+		name string
+		run  func()
+		want []string
+	}{
+		{
+			name: "single trace",
+			run: func() {
+				untrace(trace("parseExpression"))
+			},
+			want: []string{
+				"BEGIN parseExpression",
+				"END parseExpression",
+			},
+		},
+		{
+			name: "nested traces indent by level",
+			run: func() {
+				defer untrace(trace("parseStatement"))
+				untrace(trace("parseExpression"))
+			},
+			want: []string{
+				"BEGIN parseStatement",
+				"\tBEGIN parseExpression",
+				"\tEND parseExpression",
+				"END parseStatement",
+			},
+		},
+	}
+
+	origOn, origOutput, origLevel := TraceOn, TraceOutput, traceLevel
+	defer func() {
+		TraceOn, TraceOutput, traceLevel = origOn, origOutput, origLevel
+	}()
+
+	for _, tt := range tests {
+		var buf bytes.Buffer
+		TraceOn = true
+		TraceOutput = &buf
+		traceLevel = 0
+
+		tt.run()
+
+		got := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: got %d lines %v, want %d lines %v", tt.name, len(got), got, len(tt.want), tt.want)
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: line %d = %q, want %q", tt.name, i, got[i], tt.want[i])
+			}
+		}
+	}
+}
+
+func TestTraceOffIsNoop(t *testing.T) {
+	origOn, origOutput, origLevel := TraceOn, TraceOutput, traceLevel
+	defer func() {
+		TraceOn, TraceOutput, traceLevel = origOn, origOutput, origLevel
+	}()
+
+	var buf bytes.Buffer
+	TraceOn = false
+	TraceOutput = &buf
+	traceLevel = 0
+
+	untrace(trace("parseExpression"))
+
+	if buf.Len() != 0 {
+		t.Errorf("expected no output while TraceOn is false, got %q", buf.String())
+	}
+}
@@ -5,11 +5,22 @@ import (
 	"fmt"
 	"io"
 	"monkey/lexer"
-	"monkey/token"
+	"monkey/parser"
 )
 
 const PROMP = ">> "
 
+// printParserErrors writes out each error the parser collected, one per
+// line, under a small banner so they're easy to pick out from program
+// output.
+func printParserErrors(out io.Writer, errors []string) {
+	fmt.Fprintf(out, "Woops! We ran into some monkey business here!\n")
+	fmt.Fprintf(out, " parser errors:\n")
+	for _, msg := range errors {
+		fmt.Fprintf(out, "\t%s\n", msg)
+	}
+}
+
 // REPL stand for REad Eval Print Loop... 
 // A javascript console in a web browser is a REPL:
 // It allows input (Read), 
@@ -28,9 +39,15 @@ func Start (in io.Reader, out io.Writer) {
 		line := scanner.Text()
 
 		l := lexer.New(line)
+		p := parser.New(l)
 
-		for tok := l.NextToken(); tok.Type != token.EOF; tok = l.NextToken() {
-			fmt.Fprintf(out, "%+v\n", tok)
+		program := p.ParseProgram()
+		if len(p.Errors()) != 0 {
+			printParserErrors(out, p.Errors())
+			continue
 		}
+
+		io.WriteString(out, program.String())
+		io.WriteString(out, "\n")
 	}
 }
\ No newline at end of file